@@ -0,0 +1,46 @@
+/*
+ * Copyright 2024 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package callopt
+
+// Option configures a single per-call knob for the generic streaming client
+// (client/genericclient), such as a retry policy or a compressor. Callers pass Options as a
+// single variadic slice, the same slice already accepted by NewServerStreaming et al., so
+// retry/codec options compose with whatever other per-call options the client supports.
+type Option struct {
+	apply func(*CallOptions)
+}
+
+// CallOptions is the resolved result of applying an Option slice. genericclient calls Apply to
+// read back options that must be acted on at the wrapper level - a retry policy or a
+// compressor name can't be pushed down into the transport the way e.g. an RPC timeout can,
+// since retrying or (de)compressing a generic stream message is wrapper-level control flow.
+type CallOptions struct {
+	StreamRetryPolicy      *StreamRetryPolicy
+	StreamSendCompressor   string
+	StreamRecvDecompressor string
+	StreamMaxSendMsgSize   int
+	StreamMaxRecvMsgSize   int
+}
+
+// Apply resolves opts into a *CallOptions.
+func Apply(opts []Option) *CallOptions {
+	o := &CallOptions{}
+	for _, opt := range opts {
+		opt.apply(o)
+	}
+	return o
+}
@@ -0,0 +1,52 @@
+/*
+ * Copyright 2024 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package callopt
+
+// WithStreamSendCompressor compresses each outgoing message on a generic streaming call using
+// the compressor registered under name (see generic.RegisterCompressor), applied by
+// client/genericclient.NewClientStreaming / NewServerStreaming / NewBidirectionalStreaming.
+func WithStreamSendCompressor(name string) Option {
+	return Option{apply: func(o *CallOptions) {
+		o.StreamSendCompressor = name
+	}}
+}
+
+// WithStreamRecvDecompressor decompresses each incoming message on a generic streaming call
+// using the compressor registered under name (see generic.RegisterCompressor), applied by
+// client/genericclient.NewClientStreaming / NewServerStreaming / NewBidirectionalStreaming.
+func WithStreamRecvDecompressor(name string) Option {
+	return Option{apply: func(o *CallOptions) {
+		o.StreamRecvDecompressor = name
+	}}
+}
+
+// WithStreamMaxSendMsgSize bounds the size, in bytes, of a single outgoing message after
+// compression. Exceeding it fails Send with kerrors.ErrPayloadTooLarge.
+func WithStreamMaxSendMsgSize(n int) Option {
+	return Option{apply: func(o *CallOptions) {
+		o.StreamMaxSendMsgSize = n
+	}}
+}
+
+// WithStreamMaxRecvMsgSize bounds the size, in bytes, of a single incoming message after
+// decompression. Exceeding it fails Recv/CloseAndRecv with kerrors.ErrPayloadTooLarge, so a
+// StreamRetryPolicy can tell an oversized message apart from a transient transport error.
+func WithStreamMaxRecvMsgSize(n int) Option {
+	return Option{apply: func(o *CallOptions) {
+		o.StreamMaxRecvMsgSize = n
+	}}
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright 2024 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package callopt
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"time"
+)
+
+// StreamRetryPolicy configures retry behavior for
+// client/genericclient.NewServerStreamingWithRetry. Attempts are made one after another;
+// no hedged (concurrent) attempts are issued.
+type StreamRetryPolicy struct {
+	// Retryable reports whether err, observed while iterating the stream, should trigger a
+	// reconnect-and-replay instead of being returned to the caller. A nil Retryable treats
+	// every mid-stream error (other than io.EOF, see ShouldRetry) as non-retryable.
+	Retryable func(err error) bool
+	// MaxAttempts bounds the number of times the stream is (re)opened, including the first
+	// attempt. Values <= 1 disable retrying.
+	MaxAttempts int
+	// BaseBackoff and MaxBackoff bound exponential backoff with full jitter between attempts.
+	// Zero values fall back to 50ms and 5s respectively.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// ResumeToken, when set, is invoked with every message successfully received so a retry
+	// can recover the last-seen cursor.
+	ResumeToken func(resp interface{})
+	// RewriteRequest rewrites the original request before a retry attempt, typically to embed
+	// the cursor tracked via ResumeToken. A nil RewriteRequest replays the original request
+	// unchanged.
+	RewriteRequest func(req interface{}) interface{}
+}
+
+// ShouldRetry reports whether err should trigger a reconnect-and-replay. io.EOF - a stream
+// ending normally - is always terminal regardless of Retryable: a policy that only excludes
+// context.Canceled would otherwise reopen and replay the request forever on a stream that
+// simply finished.
+func (p *StreamRetryPolicy) ShouldRetry(err error) bool {
+	if err == nil || errors.Is(err, io.EOF) {
+		return false
+	}
+	return p.Retryable != nil && p.Retryable(err)
+}
+
+// Backoff returns the delay before retry attempt (0-based) is made, using exponential backoff
+// with full jitter bounded by [0, min(BaseBackoff<<attempt, MaxBackoff)].
+func (p *StreamRetryPolicy) Backoff(attempt int) time.Duration {
+	base, max := p.BaseBackoff, p.MaxBackoff
+	if base <= 0 {
+		base = 50 * time.Millisecond
+	}
+	if max <= 0 {
+		max = 5 * time.Second
+	}
+	d := base << uint(attempt)
+	if d <= 0 || d > max {
+		d = max
+	}
+	return time.Duration(rand.Int63n(int64(d) + 1))
+}
+
+// WithStreamRetryPolicy configures retries for a server-streaming generic call made
+// through client/genericclient.NewServerStreamingWithRetry. It has no effect on
+// NewServerStreaming.
+func WithStreamRetryPolicy(policy *StreamRetryPolicy) Option {
+	return Option{apply: func(o *CallOptions) {
+		o.StreamRetryPolicy = policy
+	}}
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright 2024 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package callopt
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestStreamRetryPolicyShouldRetry(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := []struct {
+		name      string
+		policy    *StreamRetryPolicy
+		err       error
+		wantRetry bool
+	}{
+		{
+			name:      "nil error never retries",
+			policy:    &StreamRetryPolicy{Retryable: func(error) bool { return true }},
+			err:       nil,
+			wantRetry: false,
+		},
+		{
+			name:      "io.EOF is always terminal even if Retryable says yes",
+			policy:    &StreamRetryPolicy{Retryable: func(error) bool { return true }},
+			err:       io.EOF,
+			wantRetry: false,
+		},
+		{
+			name:      "io.EOF is terminal with no Retryable configured",
+			policy:    &StreamRetryPolicy{},
+			err:       io.EOF,
+			wantRetry: false,
+		},
+		{
+			name:      "nil Retryable treats other errors as non-retryable",
+			policy:    &StreamRetryPolicy{},
+			err:       errBoom,
+			wantRetry: false,
+		},
+		{
+			name:      "Retryable is consulted for non-EOF errors",
+			policy:    &StreamRetryPolicy{Retryable: func(err error) bool { return errors.Is(err, errBoom) }},
+			err:       errBoom,
+			wantRetry: true,
+		},
+		{
+			name: "context.Canceled-only predicate does not mistake EOF for retryable",
+			policy: &StreamRetryPolicy{Retryable: func(err error) bool {
+				return !errors.Is(err, context.Canceled)
+			}},
+			err:       io.EOF,
+			wantRetry: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.policy.ShouldRetry(c.err); got != c.wantRetry {
+				t.Errorf("ShouldRetry(%v) = %v, want %v", c.err, got, c.wantRetry)
+			}
+		})
+	}
+}
+
+func TestStreamRetryPolicyBackoff(t *testing.T) {
+	p := &StreamRetryPolicy{BaseBackoff: 10 * time.Millisecond, MaxBackoff: 100 * time.Millisecond}
+	for attempt := 0; attempt < 10; attempt++ {
+		d := p.Backoff(attempt)
+		if d < 0 || d > p.MaxBackoff {
+			t.Fatalf("Backoff(%d) = %v, want within [0, %v]", attempt, d, p.MaxBackoff)
+		}
+	}
+}
+
+func TestStreamRetryPolicyBackoffDefaults(t *testing.T) {
+	p := &StreamRetryPolicy{}
+	d := p.Backoff(0)
+	if d < 0 || d > 5*time.Second {
+		t.Fatalf("Backoff(0) with zero-value policy = %v, want within [0, 5s]", d)
+	}
+}
@@ -30,23 +30,43 @@ import (
 	"github.com/cloudwego/kitex/pkg/generic"
 	"github.com/cloudwego/kitex/pkg/serviceinfo"
 	"github.com/cloudwego/kitex/pkg/streaming"
+	"google.golang.org/grpc/metadata"
 )
 
 type ClientStreaming interface {
 	streaming.Stream
 	Send(req interface{}) error
 	CloseAndRecv() (resp interface{}, err error)
+	Header() (metadata.MD, error)
+	Trailer() metadata.MD
+	// SendHeader sets outgoing metadata on the stream, e.g. an auth token a gRPC-transcoded
+	// service expects before the first message. It must be called before the first Send.
+	SendHeader(md metadata.MD) error
 }
 
+// ServerStreaming intentionally has no SendHeader: NewServerStreaming already sends the
+// request and closes the send side internally before returning a ServerStreaming to the
+// caller, so there is no point at which a caller could set outgoing metadata usefully.
 type ServerStreaming interface {
 	streaming.Stream
 	Recv() (resp interface{}, err error)
+	Header() (metadata.MD, error)
+	Trailer() metadata.MD
 }
 
+// BidirectionalStreaming additionally exposes CloseSend so that gRPC-transcoded generic
+// callers can half-close the send side (signal "no more requests") while still draining
+// in-flight responses via Recv, matching gRPC's typed bidi-streaming semantics.
 type BidirectionalStreaming interface {
 	streaming.Stream
 	Send(req interface{}) error
 	Recv() (resp interface{}, err error)
+	CloseSend() error
+	Header() (metadata.MD, error)
+	Trailer() metadata.MD
+	// SendHeader sets outgoing metadata on the stream, e.g. an auth token a gRPC-transcoded
+	// service expects before the first message. It must be called before the first Send.
+	SendHeader(md metadata.MD) error
 }
 
 func NewStreamingClient(destService string, g generic.Generic, opts ...client.Option) (Client, error) {
@@ -116,6 +136,7 @@ type deprecatedClientStreamingClient struct {
 	streaming.Stream
 	method     string
 	methodInfo serviceinfo.MethodInfo
+	codec      *streamCodecOptions
 }
 
 func NewClientStreaming(ctx context.Context, genericCli Client, method string, callOpts ...callopt.Option) (ClientStreaming, error) {
@@ -126,14 +147,22 @@ func NewClientStreaming(ctx context.Context, genericCli Client, method string, c
 	if gCli.modeMap != nil {
 		gCli.modeMap.LoadOrStore(method, serviceinfo.StreamingClient)
 	}
+	codec, err := newStreamCodecOptions(callOpts)
+	if err != nil {
+		return nil, err
+	}
 	stream, err := getStream(ctx, genericCli, method, callOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return &deprecatedClientStreamingClient{stream, method, gCli.svcInfo.MethodInfo(method)}, nil
+	return &deprecatedClientStreamingClient{stream, method, gCli.svcInfo.MethodInfo(method), codec}, nil
 }
 
 func (cs *deprecatedClientStreamingClient) Send(req interface{}) error {
+	req, err := cs.codec.encode(req)
+	if err != nil {
+		return err
+	}
 	_args := cs.methodInfo.NewArgs().(*generic.Args)
 	_args.Method = cs.method
 	_args.Request = req
@@ -148,12 +177,13 @@ func (cs *deprecatedClientStreamingClient) CloseAndRecv() (resp interface{}, err
 	if err = cs.Stream.RecvMsg(_result); err != nil {
 		return nil, err
 	}
-	return _result.GetSuccess(), nil
+	return cs.codec.decode(_result.GetSuccess())
 }
 
 type deprecatedServerStreamingClient struct {
 	streaming.Stream
 	methodInfo serviceinfo.MethodInfo
+	codec      *streamCodecOptions
 }
 
 func NewServerStreaming(ctx context.Context, genericCli Client, method string, req interface{}, callOpts ...callopt.Option) (ServerStreaming, error) {
@@ -164,12 +194,20 @@ func NewServerStreaming(ctx context.Context, genericCli Client, method string, r
 	if gCli.modeMap != nil {
 		gCli.modeMap.LoadOrStore(method, serviceinfo.StreamingServer)
 	}
+	codec, err := newStreamCodecOptions(callOpts)
+	if err != nil {
+		return nil, err
+	}
+	req, err = codec.encode(req)
+	if err != nil {
+		return nil, err
+	}
 	stream, err := getStream(ctx, genericCli, method, callOpts...)
 	if err != nil {
 		return nil, err
 	}
 	mtInfo := gCli.svcInfo.MethodInfo(method)
-	ss := &deprecatedServerStreamingClient{stream, mtInfo}
+	ss := &deprecatedServerStreamingClient{stream, mtInfo, codec}
 	_args := mtInfo.NewArgs().(*generic.Args)
 	_args.Method = method
 	_args.Request = req
@@ -187,13 +225,14 @@ func (ss *deprecatedServerStreamingClient) Recv() (resp interface{}, err error)
 	if err = ss.Stream.RecvMsg(_result); err != nil {
 		return nil, err
 	}
-	return _result.GetSuccess(), nil
+	return ss.codec.decode(_result.GetSuccess())
 }
 
 type deprecatedBidirectionalStreamingClient struct {
 	streaming.Stream
 	method     string
 	methodInfo serviceinfo.MethodInfo
+	codec      *streamCodecOptions
 }
 
 func NewBidirectionalStreaming(ctx context.Context, genericCli Client, method string, callOpts ...callopt.Option) (BidirectionalStreaming, error) {
@@ -204,14 +243,22 @@ func NewBidirectionalStreaming(ctx context.Context, genericCli Client, method st
 	if gCli.modeMap != nil {
 		gCli.modeMap.LoadOrStore(method, serviceinfo.StreamingBidirectional)
 	}
+	codec, err := newStreamCodecOptions(callOpts)
+	if err != nil {
+		return nil, err
+	}
 	stream, err := getStream(ctx, genericCli, method, callOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return &deprecatedBidirectionalStreamingClient{stream, method, gCli.svcInfo.MethodInfo(method)}, nil
+	return &deprecatedBidirectionalStreamingClient{stream, method, gCli.svcInfo.MethodInfo(method), codec}, nil
 }
 
 func (bs *deprecatedBidirectionalStreamingClient) Send(req interface{}) error {
+	req, err := bs.codec.encode(req)
+	if err != nil {
+		return err
+	}
 	_args := bs.methodInfo.NewArgs().(*generic.Args)
 	_args.Method = bs.method
 	_args.Request = req
@@ -223,7 +270,20 @@ func (bs *deprecatedBidirectionalStreamingClient) Recv() (resp interface{}, err
 	if err = bs.Stream.RecvMsg(_result); err != nil {
 		return nil, err
 	}
-	return _result.GetSuccess(), nil
+	return bs.codec.decode(_result.GetSuccess())
+}
+
+// closeSender is implemented by underlying streams that support half-closing the send
+// side without tearing down the whole stream (e.g. gRPC-transcoded ttstream streams).
+type closeSender interface {
+	CloseSend() error
+}
+
+func (bs *deprecatedBidirectionalStreamingClient) CloseSend() error {
+	if cs, ok := bs.Stream.(closeSender); ok {
+		return cs.CloseSend()
+	}
+	return bs.Stream.Close()
 }
 
 func getStream(ctx context.Context, genericCli Client, method string, callOpts ...callopt.Option) (streaming.Stream, error) {
@@ -0,0 +1,114 @@
+/*
+ * Copyright 2024 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package genericclient
+
+import (
+	"fmt"
+
+	"github.com/cloudwego/kitex/client/callopt"
+	"github.com/cloudwego/kitex/pkg/generic"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+// streamCodecOptions configures the per-message compression and size limits that
+// NewClientStreaming, NewServerStreaming, and NewBidirectionalStreaming apply to every
+// Send/Recv/CloseAndRecv on the returned stream.
+//
+// Compression and size enforcement require repacking the message itself, which only works
+// when the dynamic type is []byte - the payload shape produced by byte-oriented generics (the
+// HTTP and protobuf-binary Generics). Applying these options to a JSON or map generic stream,
+// whose messages are string / map[string]any, is a configuration error and is rejected rather
+// than silently skipped; see encode/decode below.
+type streamCodecOptions struct {
+	sendCompressor   generic.Compressor
+	recvDecompressor generic.Compressor
+	maxSendMsgSize   int
+	maxRecvMsgSize   int
+}
+
+// newStreamCodecOptions resolves the compression/size knobs set via callopt.WithStreamSendCompressor,
+// WithStreamRecvDecompressor, WithStreamMaxSendMsgSize, and WithStreamMaxRecvMsgSize.
+func newStreamCodecOptions(callOpts []callopt.Option) (*streamCodecOptions, error) {
+	co := callopt.Apply(callOpts)
+	o := &streamCodecOptions{
+		maxSendMsgSize: co.StreamMaxSendMsgSize,
+		maxRecvMsgSize: co.StreamMaxRecvMsgSize,
+	}
+	if co.StreamSendCompressor != "" {
+		c, ok := generic.GetCompressor(co.StreamSendCompressor)
+		if !ok {
+			return nil, fmt.Errorf("genericclient: unknown stream send compressor %q", co.StreamSendCompressor)
+		}
+		o.sendCompressor = c
+	}
+	if co.StreamRecvDecompressor != "" {
+		c, ok := generic.GetCompressor(co.StreamRecvDecompressor)
+		if !ok {
+			return nil, fmt.Errorf("genericclient: unknown stream recv decompressor %q", co.StreamRecvDecompressor)
+		}
+		o.recvDecompressor = c
+	}
+	return o, nil
+}
+
+func (o *streamCodecOptions) sendActive() bool {
+	return o.sendCompressor != nil || o.maxSendMsgSize > 0
+}
+
+func (o *streamCodecOptions) recvActive() bool {
+	return o.recvDecompressor != nil || o.maxRecvMsgSize > 0
+}
+
+func (o *streamCodecOptions) encode(msg interface{}) (interface{}, error) {
+	if !o.sendActive() {
+		return msg, nil
+	}
+	p, ok := msg.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("genericclient: stream compression/size limits only support []byte payloads, got %T", msg)
+	}
+	if o.sendCompressor != nil {
+		var err error
+		if p, err = o.sendCompressor.Compress(p); err != nil {
+			return nil, err
+		}
+	}
+	if o.maxSendMsgSize > 0 && len(p) > o.maxSendMsgSize {
+		return nil, kerrors.ErrPayloadTooLarge
+	}
+	return p, nil
+}
+
+func (o *streamCodecOptions) decode(msg interface{}) (interface{}, error) {
+	if !o.recvActive() {
+		return msg, nil
+	}
+	p, ok := msg.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("genericclient: stream compression/size limits only support []byte payloads, got %T", msg)
+	}
+	if o.recvDecompressor != nil {
+		var err error
+		if p, err = o.recvDecompressor.Decompress(p); err != nil {
+			return nil, err
+		}
+	}
+	if o.maxRecvMsgSize > 0 && len(p) > o.maxRecvMsgSize {
+		return nil, kerrors.ErrPayloadTooLarge
+	}
+	return p, nil
+}
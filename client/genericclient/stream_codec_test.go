@@ -0,0 +1,116 @@
+/*
+ * Copyright 2024 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package genericclient
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cloudwego/kitex/client/callopt"
+	"github.com/cloudwego/kitex/pkg/generic"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+func TestStreamCodecOptionsPassThroughWhenInactive(t *testing.T) {
+	o, err := newStreamCodecOptions(nil)
+	if err != nil {
+		t.Fatalf("newStreamCodecOptions(nil) error = %v", err)
+	}
+
+	// A non-[]byte payload must pass through untouched when no codec option is configured,
+	// since most generics (JSON, map) never produce []byte messages.
+	msg, err := o.encode("hello")
+	if err != nil || msg != "hello" {
+		t.Fatalf("encode(%q) = (%v, %v), want (%q, nil)", "hello", msg, err, "hello")
+	}
+	msg, err = o.decode(map[string]any{"a": 1})
+	if err != nil {
+		t.Fatalf("decode(map) error = %v, want nil", err)
+	}
+}
+
+func TestStreamCodecOptionsRejectsNonBytesPayloadWhenActive(t *testing.T) {
+	o, err := newStreamCodecOptions([]callopt.Option{callopt.WithStreamMaxSendMsgSize(10)})
+	if err != nil {
+		t.Fatalf("newStreamCodecOptions error = %v", err)
+	}
+
+	if _, err := o.encode("not bytes"); err == nil {
+		t.Fatal("encode(string) with an active size limit must fail loudly, not silently pass through")
+	}
+
+	o, err = newStreamCodecOptions([]callopt.Option{callopt.WithStreamMaxRecvMsgSize(10)})
+	if err != nil {
+		t.Fatalf("newStreamCodecOptions error = %v", err)
+	}
+	if _, err := o.decode(map[string]any{"a": 1}); err == nil {
+		t.Fatal("decode(map) with an active size limit must fail loudly, not silently pass through")
+	}
+}
+
+func TestStreamCodecOptionsMaxSendMsgSize(t *testing.T) {
+	o, err := newStreamCodecOptions([]callopt.Option{callopt.WithStreamMaxSendMsgSize(4)})
+	if err != nil {
+		t.Fatalf("newStreamCodecOptions error = %v", err)
+	}
+
+	if _, err := o.encode([]byte("ok")); err != nil {
+		t.Fatalf("encode(2 bytes) with a 4-byte limit: %v, want nil", err)
+	}
+	if _, err := o.encode([]byte("too long")); !errors.Is(err, kerrors.ErrPayloadTooLarge) {
+		t.Fatalf("encode(8 bytes) with a 4-byte limit error = %v, want %v", err, kerrors.ErrPayloadTooLarge)
+	}
+}
+
+func TestStreamCodecOptionsMaxRecvMsgSizeAfterDecompression(t *testing.T) {
+	o, err := newStreamCodecOptions([]callopt.Option{
+		callopt.WithStreamRecvDecompressor("gzip"),
+		callopt.WithStreamMaxRecvMsgSize(4),
+	})
+	if err != nil {
+		t.Fatalf("newStreamCodecOptions error = %v", err)
+	}
+
+	small, err := o.decode(gzipBytes(t, "ok"))
+	if err != nil || string(small.([]byte)) != "ok" {
+		t.Fatalf("decode(small gzip payload) = (%v, %v), want (\"ok\", nil)", small, err)
+	}
+
+	_, err = o.decode(gzipBytes(t, "too long for the limit"))
+	if !errors.Is(err, kerrors.ErrPayloadTooLarge) {
+		t.Fatalf("decode(oversized gzip payload) error = %v, want %v (enforced after decompression)", err, kerrors.ErrPayloadTooLarge)
+	}
+}
+
+func TestStreamCodecOptionsUnknownCompressor(t *testing.T) {
+	if _, err := newStreamCodecOptions([]callopt.Option{callopt.WithStreamSendCompressor("does-not-exist")}); err == nil {
+		t.Fatal("newStreamCodecOptions with an unregistered compressor name must error")
+	}
+}
+
+func gzipBytes(t *testing.T, s string) []byte {
+	t.Helper()
+	c, ok := generic.GetCompressor("gzip")
+	if !ok {
+		t.Fatal("gzip compressor not registered")
+	}
+	out, err := c.Compress([]byte(s))
+	if err != nil {
+		t.Fatalf("gzip compress: %v", err)
+	}
+	return out
+}
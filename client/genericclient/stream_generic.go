@@ -0,0 +1,150 @@
+/*
+ * Copyright 2024 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package genericclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/kitex/client/callopt"
+	"google.golang.org/grpc/metadata"
+)
+
+// ClientStreamingT is the type-parameterized counterpart of ClientStreaming. It wraps the
+// dynamic deprecatedClientStreamingClient: Send takes a typed Req directly, and CloseAndRecv
+// performs the response type assertion, so callers of a given generic.Generic no longer need
+// to do it themselves.
+type ClientStreamingT[Req, Resp any] struct {
+	stream ClientStreaming
+}
+
+// NewClientStreamingT creates a type-parameterized client-streaming generic client.
+func NewClientStreamingT[Req, Resp any](ctx context.Context, genericCli Client, method string, callOpts ...callopt.Option) (*ClientStreamingT[Req, Resp], error) {
+	cs, err := NewClientStreaming(ctx, genericCli, method, callOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientStreamingT[Req, Resp]{stream: cs}, nil
+}
+
+func (cs *ClientStreamingT[Req, Resp]) Send(req Req) error {
+	return cs.stream.Send(req)
+}
+
+func (cs *ClientStreamingT[Req, Resp]) CloseAndRecv() (resp Resp, err error) {
+	r, err := cs.stream.CloseAndRecv()
+	if err != nil {
+		return resp, err
+	}
+	resp, ok := r.(Resp)
+	if !ok {
+		return resp, fmt.Errorf("genericclient: unexpected response type %T, want %T", r, resp)
+	}
+	return resp, nil
+}
+
+func (cs *ClientStreamingT[Req, Resp]) Header() (metadata.MD, error) {
+	return cs.stream.Header()
+}
+
+func (cs *ClientStreamingT[Req, Resp]) Trailer() metadata.MD {
+	return cs.stream.Trailer()
+}
+
+func (cs *ClientStreamingT[Req, Resp]) SendHeader(md metadata.MD) error {
+	return cs.stream.SendHeader(md)
+}
+
+// ServerStreamingT is the type-parameterized counterpart of ServerStreaming.
+type ServerStreamingT[Resp any] struct {
+	stream ServerStreaming
+}
+
+// NewServerStreamingT creates a type-parameterized server-streaming generic client.
+func NewServerStreamingT[Resp any](ctx context.Context, genericCli Client, method string, req interface{}, callOpts ...callopt.Option) (*ServerStreamingT[Resp], error) {
+	ss, err := NewServerStreaming(ctx, genericCli, method, req, callOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ServerStreamingT[Resp]{stream: ss}, nil
+}
+
+func (ss *ServerStreamingT[Resp]) Recv() (resp Resp, err error) {
+	r, err := ss.stream.Recv()
+	if err != nil {
+		return resp, err
+	}
+	resp, ok := r.(Resp)
+	if !ok {
+		return resp, fmt.Errorf("genericclient: unexpected response type %T, want %T", r, resp)
+	}
+	return resp, nil
+}
+
+func (ss *ServerStreamingT[Resp]) Header() (metadata.MD, error) {
+	return ss.stream.Header()
+}
+
+func (ss *ServerStreamingT[Resp]) Trailer() metadata.MD {
+	return ss.stream.Trailer()
+}
+
+// BidirectionalStreamingT is the type-parameterized counterpart of BidirectionalStreaming.
+type BidirectionalStreamingT[Req, Resp any] struct {
+	stream BidirectionalStreaming
+}
+
+// NewBidirectionalStreamingT creates a type-parameterized bidirectional-streaming generic client.
+func NewBidirectionalStreamingT[Req, Resp any](ctx context.Context, genericCli Client, method string, callOpts ...callopt.Option) (*BidirectionalStreamingT[Req, Resp], error) {
+	bs, err := NewBidirectionalStreaming(ctx, genericCli, method, callOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &BidirectionalStreamingT[Req, Resp]{stream: bs}, nil
+}
+
+func (bs *BidirectionalStreamingT[Req, Resp]) Send(req Req) error {
+	return bs.stream.Send(req)
+}
+
+func (bs *BidirectionalStreamingT[Req, Resp]) Recv() (resp Resp, err error) {
+	r, err := bs.stream.Recv()
+	if err != nil {
+		return resp, err
+	}
+	resp, ok := r.(Resp)
+	if !ok {
+		return resp, fmt.Errorf("genericclient: unexpected response type %T, want %T", r, resp)
+	}
+	return resp, nil
+}
+
+func (bs *BidirectionalStreamingT[Req, Resp]) CloseSend() error {
+	return bs.stream.CloseSend()
+}
+
+func (bs *BidirectionalStreamingT[Req, Resp]) Header() (metadata.MD, error) {
+	return bs.stream.Header()
+}
+
+func (bs *BidirectionalStreamingT[Req, Resp]) Trailer() metadata.MD {
+	return bs.stream.Trailer()
+}
+
+func (bs *BidirectionalStreamingT[Req, Resp]) SendHeader(md metadata.MD) error {
+	return bs.stream.SendHeader(md)
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright 2024 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package genericclient
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cloudwego/kitex/pkg/streaming"
+	"google.golang.org/grpc/metadata"
+)
+
+type fakeClientStreaming struct {
+	streaming.Stream
+	sendErr         error
+	closeAndRecvR   interface{}
+	closeAndRecvErr error
+}
+
+func (f *fakeClientStreaming) Send(req interface{}) error { return f.sendErr }
+
+func (f *fakeClientStreaming) CloseAndRecv() (interface{}, error) {
+	return f.closeAndRecvR, f.closeAndRecvErr
+}
+
+func (f *fakeClientStreaming) Header() (metadata.MD, error) { return nil, nil }
+
+func (f *fakeClientStreaming) Trailer() metadata.MD { return nil }
+
+func (f *fakeClientStreaming) SendHeader(md metadata.MD) error { return nil }
+
+func TestClientStreamingTCloseAndRecv(t *testing.T) {
+	cs := &ClientStreamingT[string, string]{stream: &fakeClientStreaming{closeAndRecvR: "pong"}}
+	if err := cs.Send("ping"); err != nil {
+		t.Fatalf("Send() error = %v, want nil", err)
+	}
+	resp, err := cs.CloseAndRecv()
+	if err != nil || resp != "pong" {
+		t.Fatalf("CloseAndRecv() = (%q, %v), want (\"pong\", nil)", resp, err)
+	}
+}
+
+func TestClientStreamingTCloseAndRecvWrongType(t *testing.T) {
+	cs := &ClientStreamingT[string, string]{stream: &fakeClientStreaming{closeAndRecvR: 42}}
+	if _, err := cs.CloseAndRecv(); err == nil {
+		t.Fatal("CloseAndRecv() with a mistyped response must error, not panic or zero-value succeed")
+	}
+}
+
+func TestClientStreamingTCloseAndRecvPropagatesError(t *testing.T) {
+	wantErr := errors.New("boom")
+	cs := &ClientStreamingT[string, string]{stream: &fakeClientStreaming{closeAndRecvErr: wantErr}}
+	if _, err := cs.CloseAndRecv(); !errors.Is(err, wantErr) {
+		t.Fatalf("CloseAndRecv() error = %v, want %v", err, wantErr)
+	}
+}
+
+type fakeServerStreamingT struct {
+	streaming.Stream
+	recvR   interface{}
+	recvErr error
+}
+
+func (f *fakeServerStreamingT) Recv() (interface{}, error) { return f.recvR, f.recvErr }
+
+func (f *fakeServerStreamingT) Header() (metadata.MD, error) { return nil, nil }
+
+func (f *fakeServerStreamingT) Trailer() metadata.MD { return nil }
+
+func TestServerStreamingTRecv(t *testing.T) {
+	ss := &ServerStreamingT[string]{stream: &fakeServerStreamingT{recvR: "event"}}
+	resp, err := ss.Recv()
+	if err != nil || resp != "event" {
+		t.Fatalf("Recv() = (%q, %v), want (\"event\", nil)", resp, err)
+	}
+}
+
+func TestServerStreamingTRecvWrongType(t *testing.T) {
+	ss := &ServerStreamingT[string]{stream: &fakeServerStreamingT{recvR: 7}}
+	if _, err := ss.Recv(); err == nil {
+		t.Fatal("Recv() with a mistyped response must error, not panic or zero-value succeed")
+	}
+}
+
+type fakeBidirectionalStreaming struct {
+	streaming.Stream
+	sendErr error
+	recvR   interface{}
+	recvErr error
+}
+
+func (f *fakeBidirectionalStreaming) Send(req interface{}) error { return f.sendErr }
+
+func (f *fakeBidirectionalStreaming) Recv() (interface{}, error) { return f.recvR, f.recvErr }
+
+func (f *fakeBidirectionalStreaming) CloseSend() error { return nil }
+
+func (f *fakeBidirectionalStreaming) Header() (metadata.MD, error) { return nil, nil }
+
+func (f *fakeBidirectionalStreaming) Trailer() metadata.MD { return nil }
+
+func (f *fakeBidirectionalStreaming) SendHeader(md metadata.MD) error { return nil }
+
+func TestBidirectionalStreamingTSendRecv(t *testing.T) {
+	bs := &BidirectionalStreamingT[string, string]{stream: &fakeBidirectionalStreaming{recvR: "pong"}}
+	if err := bs.Send("ping"); err != nil {
+		t.Fatalf("Send() error = %v, want nil", err)
+	}
+	resp, err := bs.Recv()
+	if err != nil || resp != "pong" {
+		t.Fatalf("Recv() = (%q, %v), want (\"pong\", nil)", resp, err)
+	}
+	if err := bs.CloseSend(); err != nil {
+		t.Fatalf("CloseSend() error = %v, want nil", err)
+	}
+}
+
+func TestBidirectionalStreamingTRecvWrongType(t *testing.T) {
+	bs := &BidirectionalStreamingT[string, string]{stream: &fakeBidirectionalStreaming{recvR: 42}}
+	if _, err := bs.Recv(); err == nil {
+		t.Fatal("Recv() with a mistyped response must error, not panic or zero-value succeed")
+	}
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright 2024 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package genericclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/kitex/client/callopt"
+)
+
+// noRetryPolicy is used when callOpts carries no callopt.WithStreamRetryPolicy: a single
+// attempt, i.e. NewServerStreamingWithRetry behaves exactly like NewServerStreaming.
+var noRetryPolicy = &callopt.StreamRetryPolicy{MaxAttempts: 1}
+
+// retryingServerStreamingClient wraps a ServerStreaming and transparently reopens it on a
+// retryable mid-stream failure, replaying the (possibly rewritten) request so the caller's
+// Recv loop never observes the reconnect. The embedded ServerStreaming is swapped on every
+// reopen, so any method not overridden below (Header, Trailer, Close, ...) is forwarded to
+// whichever stream is currently active.
+type retryingServerStreamingClient struct {
+	ServerStreaming
+	ctx        context.Context
+	genericCli Client
+	method     string
+	req        interface{}
+	callOpts   []callopt.Option
+	policy     *callopt.StreamRetryPolicy
+	attempt    int
+}
+
+// NewServerStreamingWithRetry is the retrying counterpart of NewServerStreaming: on a
+// retryable error encountered while iterating Recv, it transparently opens a new underlying
+// streaming.Stream and keeps delivering results to the caller. This makes long-lived
+// server-streaming generic calls (change feeds, tail-log endpoints) resilient to a single
+// transport blip instead of failing the whole iteration.
+//
+// Retry behavior is configured through callopt.WithStreamRetryPolicy in callOpts; callers that
+// omit it get a single attempt, i.e. the same behavior as NewServerStreaming.
+func NewServerStreamingWithRetry(ctx context.Context, genericCli Client, method string, req interface{}, callOpts ...callopt.Option) (ServerStreaming, error) {
+	policy := callopt.Apply(callOpts).StreamRetryPolicy
+	if policy == nil {
+		policy = noRetryPolicy
+	}
+	rs := &retryingServerStreamingClient{
+		ctx:        ctx,
+		genericCli: genericCli,
+		method:     method,
+		req:        req,
+		callOpts:   callOpts,
+		policy:     policy,
+	}
+	if err := rs.open(); err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+func (rs *retryingServerStreamingClient) open() error {
+	req := rs.req
+	if rs.attempt > 0 && rs.policy.RewriteRequest != nil {
+		req = rs.policy.RewriteRequest(rs.req)
+	}
+	ss, err := NewServerStreaming(rs.ctx, rs.genericCli, rs.method, req, rs.callOpts...)
+	if err != nil {
+		return err
+	}
+	rs.ServerStreaming = ss
+	rs.attempt++
+	return nil
+}
+
+func (rs *retryingServerStreamingClient) Recv() (resp interface{}, err error) {
+	for {
+		resp, err = rs.ServerStreaming.Recv()
+		if err == nil {
+			if rs.policy.ResumeToken != nil {
+				rs.policy.ResumeToken(resp)
+			}
+			return resp, nil
+		}
+		maxAttempts := rs.policy.MaxAttempts
+		if maxAttempts < 1 {
+			maxAttempts = 1
+		}
+		if rs.attempt >= maxAttempts || !rs.policy.ShouldRetry(err) {
+			return nil, err
+		}
+		time.Sleep(rs.policy.Backoff(rs.attempt - 1))
+		if openErr := rs.open(); openErr != nil {
+			return nil, openErr
+		}
+	}
+}
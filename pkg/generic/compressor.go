@@ -0,0 +1,95 @@
+/*
+ * Copyright 2024 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generic
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// Compressor compresses and decompresses a single message payload. Implementations must be
+// safe for concurrent use, the same way a Generic is shared across all calls of a client.
+type Compressor interface {
+	// Name identifies the compressor, e.g. "gzip", "snappy", "zstd". It is the name passed to
+	// RegisterCompressor and looked up by GetCompressor.
+	Name() string
+	Compress(p []byte) ([]byte, error)
+	Decompress(p []byte) ([]byte, error)
+}
+
+var compressorRegistry sync.Map // name string -> Compressor
+
+// RegisterCompressor registers c under c.Name() so it can be selected by name from
+// callopt.WithStreamSendCompressor / WithStreamRecvDecompressor. Registering a name a second
+// time overwrites the previous registration. Only identity and gzip are registered by default;
+// no snappy or zstd compressor is provided, so those names resolve only after a caller
+// registers a Compressor for them.
+func RegisterCompressor(c Compressor) {
+	compressorRegistry.Store(c.Name(), c)
+}
+
+// GetCompressor looks up a Compressor previously passed to RegisterCompressor.
+func GetCompressor(name string) (Compressor, bool) {
+	c, ok := compressorRegistry.Load(name)
+	if !ok {
+		return nil, false
+	}
+	return c.(Compressor), true
+}
+
+func init() {
+	RegisterCompressor(identityCompressor{})
+	RegisterCompressor(gzipCompressor{})
+}
+
+type identityCompressor struct{}
+
+func (identityCompressor) Name() string                       { return "identity" }
+func (identityCompressor) Compress(p []byte) ([]byte, error)   { return p, nil }
+func (identityCompressor) Decompress(p []byte) ([]byte, error) { return p, nil }
+
+type gzipCompressor struct{}
+
+func (gzipCompressor) Name() string { return "gzip" }
+
+func (gzipCompressor) Compress(p []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(p); err != nil {
+		return nil, fmt.Errorf("generic: gzip compress: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("generic: gzip compress: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (gzipCompressor) Decompress(p []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(p))
+	if err != nil {
+		return nil, fmt.Errorf("generic: gzip decompress: %w", err)
+	}
+	defer r.Close()
+	out, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("generic: gzip decompress: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright 2024 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generic
+
+import "context"
+
+// ClientStreamingServer is the server-side view of a client-streaming call: the handler reads
+// the client's messages until io.EOF and returns a single response.
+type ClientStreamingServer interface {
+	Recv() (req interface{}, err error)
+}
+
+// ServerStreamingServer is the server-side view of a server-streaming call: the handler sends
+// zero or more responses for the single request it was invoked with.
+type ServerStreamingServer interface {
+	Send(resp interface{}) error
+}
+
+// BidirectionalStreamingServer is the server-side view of a bidirectional-streaming call.
+type BidirectionalStreamingServer interface {
+	Recv() (req interface{}, err error)
+	Send(resp interface{}) error
+}
+
+// StreamingServiceImpl is implemented by generic streaming service handlers registered through
+// genericserver.NewStreamingServer. A handler only needs to implement the streaming modes its methods
+// actually use; unused methods may be left unimplemented by embedding
+// UnimplementedStreamingService. genericserver resolves which Handle* method to invoke per
+// call the same way genericclient resolves GenericMethod on the client side: by looking up the
+// per-method StreamingMode, either from the IDL (when the Generic carries one) or from the
+// streaming mode recorded at first-call time for non-IDL generics (map/JSON).
+type StreamingServiceImpl interface {
+	HandleClientStreaming(ctx context.Context, stream ClientStreamingServer) (resp interface{}, err error)
+	HandleServerStreaming(ctx context.Context, req interface{}, stream ServerStreamingServer) error
+	HandleBidirectionalStreaming(ctx context.Context, stream BidirectionalStreamingServer) error
+}
+
+// UnimplementedStreamingService can be embedded in a StreamingServiceImpl to satisfy methods
+// the service does not support; calling an unimplemented mode returns an error instead of
+// panicking on a missing method.
+type UnimplementedStreamingService struct{}
+
+func (UnimplementedStreamingService) HandleClientStreaming(ctx context.Context, stream ClientStreamingServer) (resp interface{}, err error) {
+	return nil, errStreamingModeNotImplemented("client streaming")
+}
+
+func (UnimplementedStreamingService) HandleServerStreaming(ctx context.Context, req interface{}, stream ServerStreamingServer) error {
+	return errStreamingModeNotImplemented("server streaming")
+}
+
+func (UnimplementedStreamingService) HandleBidirectionalStreaming(ctx context.Context, stream BidirectionalStreamingServer) error {
+	return errStreamingModeNotImplemented("bidirectional streaming")
+}
+
+func errStreamingModeNotImplemented(mode string) error {
+	return &streamingModeNotImplementedError{mode}
+}
+
+type streamingModeNotImplementedError struct{ mode string }
+
+func (e *streamingModeNotImplementedError) Error() string {
+	return "generic: " + e.mode + " is not implemented by this service"
+}
@@ -0,0 +1,36 @@
+/*
+ * Copyright 2024 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generic
+
+import (
+	"context"
+	"testing"
+)
+
+func TestUnimplementedStreamingService(t *testing.T) {
+	var svc UnimplementedStreamingService
+
+	if _, err := svc.HandleClientStreaming(context.Background(), nil); err == nil {
+		t.Fatal("HandleClientStreaming() error = nil, want a not-implemented error")
+	}
+	if err := svc.HandleServerStreaming(context.Background(), nil, nil); err == nil {
+		t.Fatal("HandleServerStreaming() error = nil, want a not-implemented error")
+	}
+	if err := svc.HandleBidirectionalStreaming(context.Background(), nil); err == nil {
+		t.Fatal("HandleBidirectionalStreaming() error = nil, want a not-implemented error")
+	}
+}
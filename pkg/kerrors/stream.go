@@ -0,0 +1,26 @@
+/*
+ * Copyright 2024 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kerrors
+
+import "errors"
+
+// ErrPayloadTooLarge is returned when a single streaming message exceeds the configured max
+// send size (measured after compression) or max recv size (measured after decompression) (see callopt.WithStreamMaxSendMsgSize and
+// WithStreamMaxRecvMsgSize). It is a distinct sentinel so a streaming retry policy
+// (see callopt.StreamRetryPolicy) can choose not to retry an oversized message, since
+// retrying would just reproduce the same failure.
+var ErrPayloadTooLarge = errors.New("kerrors: message too large")
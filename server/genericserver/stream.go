@@ -0,0 +1,159 @@
+/*
+ * Copyright 2024 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package genericserver is the server-side counterpart of client/genericclient: it lets a
+// generic.Generic (map, JSON, HTTP, protobuf-binary, ...) be served without generated code, the
+// same way genericclient lets one be called without generated code. NewStreamingServer is the
+// missing symmetric half of genericclient.NewStreamingClient.
+package genericserver
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cloudwego/kitex/pkg/generic"
+	"github.com/cloudwego/kitex/pkg/serviceinfo"
+	"github.com/cloudwego/kitex/pkg/streaming"
+	"github.com/cloudwego/kitex/server"
+)
+
+// NewStreamingServer creates a kitex server that dispatches every call to handler based on the
+// method's streaming mode, resolved through svcInfo.GenericMethod the same way
+// genericclient.NewStreamingClientWithServiceInfo resolves it on the client side.
+func NewStreamingServer(handler generic.StreamingServiceImpl, g generic.Generic, opts ...server.Option) (server.Server, error) {
+	return NewStreamingServerWithServiceInfo(handler, g, generic.ServiceInfoWithGeneric(g), opts...)
+}
+
+// NewStreamingServerWithServiceInfo is NewStreamingServer for callers that already built a
+// custom serviceinfo.ServiceInfo, mirroring
+// genericclient.NewStreamingClientWithServiceInfo.
+func NewStreamingServerWithServiceInfo(handler generic.StreamingServiceImpl, g generic.Generic, svcInfo *serviceinfo.ServiceInfo, opts ...server.Option) (server.Server, error) {
+	var mp *sync.Map
+	if !generic.HasIDLInfo(g) {
+		mp = &sync.Map{}
+	}
+
+	svr := server.NewServer(opts...)
+	if err := svr.RegisterService(svcInfo, &streamingServiceHandler{handler: handler, g: g, modeMap: mp}); err != nil {
+		return nil, err
+	}
+
+	svcInfo.GenericMethod = func(name string) serviceinfo.MethodInfo {
+		key := serviceinfo.GenericMethod
+		if !generic.HasIDLInfo(g) {
+			if mode, ok := mp.Load(name); ok {
+				key = streamingMethodInfoKey(mode.(serviceinfo.StreamingMode))
+			}
+			return svcInfo.Methods[key]
+		}
+		n, err := g.GetMethod(nil, name)
+		if err != nil {
+			return svcInfo.Methods[key]
+		}
+		key = streamingMethodInfoKey(n.StreamingMode)
+		return svcInfo.Methods[key]
+	}
+
+	return svr, nil
+}
+
+// streamingMethodInfoKey maps a streaming mode to the serviceinfo.Methods key registered for
+// it, mirroring genericclient's getGenericStreamingMethodInfoKey on the client side.
+func streamingMethodInfoKey(streamingMode serviceinfo.StreamingMode) string {
+	switch streamingMode {
+	case serviceinfo.StreamingClient:
+		return serviceinfo.GenericClientStreamingMethod
+	case serviceinfo.StreamingServer:
+		return serviceinfo.GenericServerStreamingMethod
+	case serviceinfo.StreamingBidirectional:
+		return serviceinfo.GenericBidirectionalStreamingMethod
+	default:
+		return serviceinfo.GenericMethod
+	}
+}
+
+// streamingServiceHandler adapts a generic.StreamingServiceImpl to the serviceinfo.MethodInfo
+// handler signature that the generated streaming methods (GenericClientStreamingMethod,
+// GenericServerStreamingMethod, GenericBidirectionalStreamingMethod) invoke, recording the
+// streaming mode observed for each non-IDL method name at first-call time so that later calls
+// on the same method (e.g. GetMethod lookups for logging/middleware) can resolve it too.
+type streamingServiceHandler struct {
+	handler generic.StreamingServiceImpl
+	g       generic.Generic
+	modeMap *sync.Map
+}
+
+func (h *streamingServiceHandler) recordMode(method string, mode serviceinfo.StreamingMode) {
+	if h.modeMap != nil {
+		h.modeMap.LoadOrStore(method, mode)
+	}
+}
+
+// HandleClientStreaming adapts the raw streaming.Stream from the client-streaming generated
+// method to generic.ClientStreamingServer and invokes the user handler.
+func (h *streamingServiceHandler) HandleClientStreaming(ctx context.Context, method string, stream streaming.Stream) (resp interface{}, err error) {
+	h.recordMode(method, serviceinfo.StreamingClient)
+	return h.handler.HandleClientStreaming(ctx, &clientStreamingServer{stream})
+}
+
+// HandleServerStreaming adapts the raw streaming.Stream from the server-streaming generated
+// method to generic.ServerStreamingServer and invokes the user handler.
+func (h *streamingServiceHandler) HandleServerStreaming(ctx context.Context, method string, req interface{}, stream streaming.Stream) error {
+	h.recordMode(method, serviceinfo.StreamingServer)
+	return h.handler.HandleServerStreaming(ctx, req, &serverStreamingServer{stream})
+}
+
+// HandleBidirectionalStreaming adapts the raw streaming.Stream from the bidirectional-
+// streaming generated method to generic.BidirectionalStreamingServer and invokes the user
+// handler.
+func (h *streamingServiceHandler) HandleBidirectionalStreaming(ctx context.Context, method string, stream streaming.Stream) error {
+	h.recordMode(method, serviceinfo.StreamingBidirectional)
+	return h.handler.HandleBidirectionalStreaming(ctx, &bidirectionalStreamingServer{stream})
+}
+
+type clientStreamingServer struct{ streaming.Stream }
+
+func (s *clientStreamingServer) Recv() (req interface{}, err error) {
+	_args := new(generic.Args)
+	if err = s.Stream.RecvMsg(_args); err != nil {
+		return nil, err
+	}
+	return _args.Request, nil
+}
+
+type serverStreamingServer struct{ streaming.Stream }
+
+func (s *serverStreamingServer) Send(resp interface{}) error {
+	_result := new(generic.Result)
+	_result.SetSuccess(resp)
+	return s.Stream.SendMsg(_result)
+}
+
+type bidirectionalStreamingServer struct{ streaming.Stream }
+
+func (s *bidirectionalStreamingServer) Recv() (req interface{}, err error) {
+	_args := new(generic.Args)
+	if err = s.Stream.RecvMsg(_args); err != nil {
+		return nil, err
+	}
+	return _args.Request, nil
+}
+
+func (s *bidirectionalStreamingServer) Send(resp interface{}) error {
+	_result := new(generic.Result)
+	_result.SetSuccess(resp)
+	return s.Stream.SendMsg(_result)
+}
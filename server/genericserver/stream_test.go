@@ -0,0 +1,174 @@
+/*
+ * Copyright 2024 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package genericserver
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/cloudwego/kitex/pkg/generic"
+	"github.com/cloudwego/kitex/pkg/serviceinfo"
+	"github.com/cloudwego/kitex/pkg/streaming"
+)
+
+func TestStreamingMethodInfoKey(t *testing.T) {
+	cases := []struct {
+		mode serviceinfo.StreamingMode
+		want string
+	}{
+		{serviceinfo.StreamingClient, serviceinfo.GenericClientStreamingMethod},
+		{serviceinfo.StreamingServer, serviceinfo.GenericServerStreamingMethod},
+		{serviceinfo.StreamingBidirectional, serviceinfo.GenericBidirectionalStreamingMethod},
+		{serviceinfo.StreamingNone, serviceinfo.GenericMethod},
+	}
+	for _, c := range cases {
+		if got := streamingMethodInfoKey(c.mode); got != c.want {
+			t.Errorf("streamingMethodInfoKey(%v) = %q, want %q", c.mode, got, c.want)
+		}
+	}
+}
+
+// fakeStream embeds a nil streaming.Stream so the adapter types under test compile against the
+// real interface without this package needing to know its full method set; only RecvMsg/SendMsg,
+// the two methods the adapters actually call, are overridden.
+type fakeStream struct {
+	streaming.Stream
+	recvMsgErr error
+	recvArgs   *generic.Args
+	sendResult *generic.Result
+}
+
+func (f *fakeStream) RecvMsg(m interface{}) error {
+	if f.recvMsgErr != nil {
+		return f.recvMsgErr
+	}
+	*m.(*generic.Args) = *f.recvArgs
+	return nil
+}
+
+func (f *fakeStream) SendMsg(m interface{}) error {
+	f.sendResult = m.(*generic.Result)
+	return nil
+}
+
+func TestClientStreamingServerRecv(t *testing.T) {
+	args := new(generic.Args)
+	args.Request = "hello"
+	s := &clientStreamingServer{&fakeStream{recvArgs: args}}
+
+	req, err := s.Recv()
+	if err != nil || req != "hello" {
+		t.Fatalf("Recv() = (%v, %v), want (\"hello\", nil)", req, err)
+	}
+}
+
+func TestServerStreamingServerSend(t *testing.T) {
+	fs := &fakeStream{}
+	s := &serverStreamingServer{fs}
+
+	if err := s.Send("world"); err != nil {
+		t.Fatalf("Send() error = %v, want nil", err)
+	}
+	if fs.sendResult.GetSuccess() != "world" {
+		t.Fatalf("sent result = %v, want %q", fs.sendResult.GetSuccess(), "world")
+	}
+}
+
+func TestBidirectionalStreamingServerSendRecv(t *testing.T) {
+	args := new(generic.Args)
+	args.Request = "ping"
+	fs := &fakeStream{recvArgs: args}
+	s := &bidirectionalStreamingServer{fs}
+
+	req, err := s.Recv()
+	if err != nil || req != "ping" {
+		t.Fatalf("Recv() = (%v, %v), want (\"ping\", nil)", req, err)
+	}
+	if err := s.Send("pong"); err != nil {
+		t.Fatalf("Send() error = %v, want nil", err)
+	}
+	if fs.sendResult.GetSuccess() != "pong" {
+		t.Fatalf("sent result = %v, want %q", fs.sendResult.GetSuccess(), "pong")
+	}
+}
+
+// fakeStreamingServiceImpl records which Handle* method was invoked so tests can assert
+// streamingServiceHandler dispatches to the right one and records the right mode.
+type fakeStreamingServiceImpl struct {
+	generic.UnimplementedStreamingService
+	clientStreamingCalled        bool
+	serverStreamingCalled        bool
+	bidirectionalStreamingCalled bool
+}
+
+func (f *fakeStreamingServiceImpl) HandleClientStreaming(ctx context.Context, stream generic.ClientStreamingServer) (interface{}, error) {
+	f.clientStreamingCalled = true
+	return nil, nil
+}
+
+func (f *fakeStreamingServiceImpl) HandleServerStreaming(ctx context.Context, req interface{}, stream generic.ServerStreamingServer) error {
+	f.serverStreamingCalled = true
+	return nil
+}
+
+func (f *fakeStreamingServiceImpl) HandleBidirectionalStreaming(ctx context.Context, stream generic.BidirectionalStreamingServer) error {
+	f.bidirectionalStreamingCalled = true
+	return nil
+}
+
+func TestStreamingServiceHandlerDispatchAndRecordMode(t *testing.T) {
+	impl := &fakeStreamingServiceImpl{}
+	mp := &sync.Map{}
+	h := &streamingServiceHandler{handler: impl, modeMap: mp}
+
+	if _, err := h.HandleClientStreaming(context.Background(), "Foo", &fakeStream{recvArgs: new(generic.Args)}); err != nil {
+		t.Fatalf("HandleClientStreaming() error = %v, want nil", err)
+	}
+	if !impl.clientStreamingCalled {
+		t.Error("HandleClientStreaming() did not invoke the user handler")
+	}
+	if mode, ok := mp.Load("Foo"); !ok || mode != serviceinfo.StreamingClient {
+		t.Errorf("modeMap[Foo] = (%v, %v), want (StreamingClient, true)", mode, ok)
+	}
+
+	if err := h.HandleServerStreaming(context.Background(), "Bar", "req", &fakeStream{recvArgs: new(generic.Args)}); err != nil {
+		t.Fatalf("HandleServerStreaming() error = %v, want nil", err)
+	}
+	if !impl.serverStreamingCalled {
+		t.Error("HandleServerStreaming() did not invoke the user handler")
+	}
+	if mode, ok := mp.Load("Bar"); !ok || mode != serviceinfo.StreamingServer {
+		t.Errorf("modeMap[Bar] = (%v, %v), want (StreamingServer, true)", mode, ok)
+	}
+
+	if err := h.HandleBidirectionalStreaming(context.Background(), "Baz", &fakeStream{recvArgs: new(generic.Args)}); err != nil {
+		t.Fatalf("HandleBidirectionalStreaming() error = %v, want nil", err)
+	}
+	if !impl.bidirectionalStreamingCalled {
+		t.Error("HandleBidirectionalStreaming() did not invoke the user handler")
+	}
+	if mode, ok := mp.Load("Baz"); !ok || mode != serviceinfo.StreamingBidirectional {
+		t.Errorf("modeMap[Baz] = (%v, %v), want (StreamingBidirectional, true)", mode, ok)
+	}
+}
+
+func TestStreamingServiceHandlerRecordModeNoopWithNilModeMap(t *testing.T) {
+	h := &streamingServiceHandler{handler: &fakeStreamingServiceImpl{}}
+	// Must not panic when modeMap is nil, i.e. the Generic carries IDL info.
+	h.recordMode("Foo", serviceinfo.StreamingClient)
+}